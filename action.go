@@ -0,0 +1,12 @@
+package soldr
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Action is a caller-supplied function run against the message under
+// evaluation, used by AssertCustom/AssertCustomWhenInMask and the
+// before/success/post hooks on Subject.
+type Action[T proto.Message] func(ctx context.Context, message T) error
@@ -0,0 +1,135 @@
+package soldr
+
+import (
+	"errors"
+	"fmt"
+)
+
+// FaultType is a Kubernetes-style discriminant describing the kind of
+// infraction a Fault represents. FaultHandler implementations can switch on
+// this to produce transport-appropriate output (e.g. a JSON error code)
+// instead of parsing the rendered message.
+type FaultType string
+
+const (
+	// FaultTypeRequest marks a fault raised outside of field evaluation,
+	// e.g. from BeforeValidation or OnSuccess.
+	FaultTypeRequest      FaultType = "Request"
+	FaultTypeRequired     FaultType = "Required"
+	FaultTypeInvalid      FaultType = "Invalid"
+	FaultTypeNotSupported FaultType = "NotSupported"
+	FaultTypeDuplicate    FaultType = "Duplicate"
+	FaultTypeTooLong      FaultType = "TooLong"
+	FaultTypeTooMany      FaultType = "TooMany"
+	FaultTypeForbidden    FaultType = "Forbidden"
+	FaultTypeInvalidType  FaultType = "TypeInvalid"
+)
+
+// Fault describes a single infraction found while evaluating a Subject. Field
+// is the dotted path the infraction was found at (empty for request-level
+// faults). Type is the discriminant that drives formatting; BadValue and
+// Detail carry the structured payload a caller needs to explain it to the
+// caller without re-parsing Err.
+type Fault struct {
+	Field    string
+	Type     FaultType
+	BadValue interface{}
+	Detail   string
+	Err      error
+}
+
+// Error renders the fault as a Kubernetes-style message, e.g.
+// `user.email: Invalid value "x": must be a valid email address`.
+func (f *Fault) Error() string {
+	switch f.Type {
+	case FaultTypeRequired:
+		return fmt.Sprintf("%s: Required value", f.Field)
+	case FaultTypeInvalid:
+		return fmt.Sprintf("%s: Invalid value %q: %s", f.Field, fmt.Sprint(f.BadValue), f.Detail)
+	case FaultTypeNotSupported:
+		return fmt.Sprintf("%s: Unsupported value %q: %s", f.Field, fmt.Sprint(f.BadValue), f.Detail)
+	case FaultTypeDuplicate:
+		return fmt.Sprintf("%s: Duplicate value %q", f.Field, fmt.Sprint(f.BadValue))
+	case FaultTypeTooLong:
+		return fmt.Sprintf("%s: Too long: %s", f.Field, f.Detail)
+	case FaultTypeTooMany:
+		return fmt.Sprintf("%s: Too many: %s", f.Field, f.Detail)
+	case FaultTypeForbidden:
+		return fmt.Sprintf("%s: Forbidden: %s", f.Field, f.Detail)
+	case FaultTypeInvalidType:
+		return fmt.Sprintf("%s: Invalid type: %s", f.Field, f.Detail)
+	default:
+		if f.Field == "" {
+			return f.Err.Error()
+		}
+		return fmt.Sprintf("%s: %s", f.Field, f.Err)
+	}
+}
+
+// RequestFault wraps an error raised outside of field evaluation, e.g. from
+// BeforeValidation or OnSuccess.
+func RequestFault(err error) *Fault {
+	return &Fault{Type: FaultTypeRequest, Err: err}
+}
+
+// FieldFault wraps a free-form error raised against a specific field, e.g.
+// from AssertCustom.
+func FieldFault(field string, err error) *Fault {
+	return &Fault{Field: field, Err: err}
+}
+
+// RequiredFault reports that a field was not set.
+func RequiredFault(field string) *Fault {
+	return &Fault{Field: field, Type: FaultTypeRequired, Err: errors.New("required value")}
+}
+
+// InvalidFault reports that a field's value failed some validation rule.
+func InvalidFault(field string, value interface{}, detail string) *Fault {
+	return &Fault{Field: field, Type: FaultTypeInvalid, BadValue: value, Detail: detail}
+}
+
+// NotSupportedFault reports that a field's value is not one of the allowed values.
+func NotSupportedFault(field string, value interface{}, allowed []interface{}) *Fault {
+	return &Fault{
+		Field:    field,
+		Type:     FaultTypeNotSupported,
+		BadValue: value,
+		Detail:   fmt.Sprintf("supported values: %v", allowed),
+	}
+}
+
+// DuplicateFault reports that a field's value was seen more than once where
+// uniqueness is required.
+func DuplicateFault(field string, value interface{}) *Fault {
+	return &Fault{Field: field, Type: FaultTypeDuplicate, BadValue: value}
+}
+
+// TooLongFault reports that a field's value exceeds the given maximum length.
+func TooLongFault(field string, value interface{}, max int) *Fault {
+	return &Fault{
+		Field:    field,
+		Type:     FaultTypeTooLong,
+		BadValue: value,
+		Detail:   fmt.Sprintf("may not be longer than %d", max),
+	}
+}
+
+// TooManyFault reports that a repeated field has more elements than allowed.
+func TooManyFault(field string, actual, max int) *Fault {
+	return &Fault{
+		Field:  field,
+		Type:   FaultTypeTooMany,
+		Detail: fmt.Sprintf("must have at most %d items, had %d", max, actual),
+	}
+}
+
+// ForbiddenFault reports that a field may not be set under the current
+// conditions, e.g. an immutable or output-only field.
+func ForbiddenFault(field, detail string) *Fault {
+	return &Fault{Field: field, Type: FaultTypeForbidden, Detail: detail}
+}
+
+// TypeInvalidFault reports that a field's value is not of the expected type.
+func TypeInvalidFault(field string, value interface{}) *Fault {
+	return &Fault{Field: field, Type: FaultTypeInvalidType, BadValue: value}
+}
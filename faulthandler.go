@@ -0,0 +1,74 @@
+package soldr
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// FaultHandler converts the aggregated faults from a failed Evaluate call
+// into the error a caller ultimately sees. Implementations have access to
+// each Fault's discriminant (Type) and structured payload (BadValue, Detail)
+// so they are not reduced to parsing a rendered message.
+type FaultHandler interface {
+	ToError(faults []*Fault) error
+}
+
+// NewFaultHandler returns the built-in FaultHandler for the given output
+// Format. Pass the result to CustomFaultHandler to opt into JSON output.
+func NewFaultHandler(format Format) FaultHandler {
+	switch format {
+	case JSON:
+		return jsonFaultHandler{}
+	default:
+		return defaultFaultHandler{}
+	}
+}
+
+func newDefaultFaultHandler() FaultHandler {
+	return defaultFaultHandler{}
+}
+
+// defaultFaultHandler renders each fault as a Kubernetes-style message and
+// joins them into a single error.
+type defaultFaultHandler struct{}
+
+func (defaultFaultHandler) ToError(faults []*Fault) error {
+	errs := make([]error, 0, len(faults))
+	for _, f := range faults {
+		errs = append(errs, f)
+	}
+	return errors.Join(errs...)
+}
+
+// jsonFaultHandler renders the faults as a JSON array of
+// {"field","type","badValue","detail"} objects, suitable for an API response
+// body.
+type jsonFaultHandler struct{}
+
+type faultJSON struct {
+	Field    string      `json:"field"`
+	Type     FaultType   `json:"type"`
+	BadValue interface{} `json:"badValue,omitempty"`
+	Detail   string      `json:"detail,omitempty"`
+}
+
+func (jsonFaultHandler) ToError(faults []*Fault) error {
+	out := make([]faultJSON, 0, len(faults))
+	for _, f := range faults {
+		detail := f.Detail
+		if detail == "" && f.Err != nil {
+			detail = f.Err.Error()
+		}
+		out = append(out, faultJSON{
+			Field:    f.Field,
+			Type:     f.Type,
+			BadValue: f.BadValue,
+			Detail:   detail,
+		})
+	}
+	b, err := json.Marshal(out)
+	if err != nil {
+		return err
+	}
+	return errors.New(string(b))
+}
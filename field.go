@@ -0,0 +1,33 @@
+package soldr
+
+// Field carries everything a Trait or Action needs to decide whether, and
+// how, a single path should be evaluated.
+type Field struct {
+	// Path is the dotted field path this field was declared against.
+	Path string
+
+	// Value is the value supplied by the caller at assert time.
+	Value interface{}
+
+	// InMask reports whether Path was present in the Subject's field mask.
+	InMask bool
+
+	// IsSet reports whether the field is set on the underlying message.
+	IsSet bool
+}
+
+// NewField builds a Field for use in a Policy.
+func NewField(path string, value interface{}, inMask, isSet bool) *Field {
+	return &Field{Path: path, Value: value, InMask: inMask, IsSet: isSet}
+}
+
+// MustBeSet always triggers evaluation, regardless of field mask.
+func (f *Field) MustBeSet() bool {
+	return true
+}
+
+// MustBeSetIfInMask only triggers evaluation when the field was present in
+// the Subject's field mask.
+func (f *Field) MustBeSetIfInMask() bool {
+	return f.InMask
+}
@@ -0,0 +1,225 @@
+package soldr
+
+import (
+	"reflect"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// fieldProcessor resolves dotted field paths against a proto.Message via
+// protoreflect, so callers can ask whether a (possibly nested) field is set
+// without hand-rolling reflection at every call site.
+type fieldProcessor struct{}
+
+func newFieldProcessor() *fieldProcessor {
+	return &fieldProcessor{}
+}
+
+// isFieldSet reports whether the field at path is set on message. Each
+// segment between dots is looked up by proto field name on the current
+// message, descending into nested messages as it goes.
+func (fp *fieldProcessor) isFieldSet(path string, message proto.Message) bool {
+	if message == nil {
+		return false
+	}
+	m := message.ProtoReflect()
+	segments := strings.Split(path, ".")
+	for i, seg := range segments {
+		if !m.IsValid() {
+			return false
+		}
+		fd := m.Descriptor().Fields().ByName(protoreflect.Name(seg))
+		if fd == nil {
+			return false
+		}
+		if i == len(segments)-1 {
+			return m.Has(fd)
+		}
+		if fd.Message() == nil {
+			return false
+		}
+		m = m.Get(fd).Message()
+	}
+	return false
+}
+
+// value resolves path against message and returns the value held there,
+// e.g. to read both sides of an immutability check via protoreflect instead
+// of requiring the caller to pass both values explicitly.
+func (fp *fieldProcessor) value(path string, message proto.Message) (interface{}, bool) {
+	if message == nil {
+		return nil, false
+	}
+	m := message.ProtoReflect()
+	segments := strings.Split(path, ".")
+	for i, seg := range segments {
+		if !m.IsValid() {
+			return nil, false
+		}
+		fd := m.Descriptor().Fields().ByName(protoreflect.Name(seg))
+		if fd == nil {
+			return nil, false
+		}
+		if i == len(segments)-1 {
+			return m.Get(fd).Interface(), true
+		}
+		if fd.Message() == nil {
+			return nil, false
+		}
+		m = m.Get(fd).Message()
+	}
+	return nil, false
+}
+
+// isFieldSetByPath is the O(1)-per-level equivalent of isFieldSet for a
+// Path that has already been resolved to a traversal chain, avoiding the
+// by-name descriptor lookups at every level. Unlike a plain descriptor
+// chain, steps carries Index/Key segments too, so it can walk through
+// repeated and map fields instead of only singular nested messages.
+func (fp *fieldProcessor) isFieldSetByPath(steps []resolvedStep, message proto.Message) bool {
+	if message == nil || len(steps) == 0 {
+		return false
+	}
+	return fp.walkSteps(steps, message.ProtoReflect())
+}
+
+// walkSteps walks steps against m, a message already positioned at the
+// level steps[0] applies to. steps[0] must be a segChild step (the root,
+// and every step immediately following an Index/Key, are segChild).
+func (fp *fieldProcessor) walkSteps(steps []resolvedStep, m protoreflect.Message) bool {
+	if !m.IsValid() || steps[0].kind != segChild {
+		return false
+	}
+	fd := steps[0].fd
+	rest := steps[1:]
+	if len(rest) == 0 {
+		return m.Has(fd)
+	}
+
+	val := m.Get(fd)
+	switch {
+	case fd.IsList():
+		if rest[0].kind != segIndex {
+			return false
+		}
+		list := val.List()
+		idx := rest[0].idx
+		if idx < 0 || idx >= list.Len() {
+			return false
+		}
+		rest = rest[1:]
+		if len(rest) == 0 {
+			return true
+		}
+		if fd.Message() == nil {
+			return false
+		}
+		return fp.walkSteps(rest, list.Get(idx).Message())
+	case fd.IsMap():
+		if rest[0].kind != segKey {
+			return false
+		}
+		mapKey, ok := mapKeyFor(fd, rest[0].key)
+		if !ok {
+			return false
+		}
+		mp := val.Map()
+		if !mp.Has(mapKey) {
+			return false
+		}
+		rest = rest[1:]
+		if len(rest) == 0 {
+			return true
+		}
+		if fd.MapValue().Kind() != protoreflect.MessageKind {
+			return false
+		}
+		return fp.walkSteps(rest, mp.Get(mapKey).Message())
+	default:
+		if fd.Message() == nil {
+			return false
+		}
+		return fp.walkSteps(rest, val.Message())
+	}
+}
+
+// mapKeyFor converts an arbitrary Go key (e.g. an untyped int from
+// Path.Key) to a protoreflect.MapKey of fd's declared key kind.
+// protoreflect.ValueOf only accepts the exact Go types it uses internally
+// (int32/int64/uint32/uint64/string/bool), so a key coming from a fluent,
+// loosely-typed call site has to be normalized here rather than passed
+// through as-is.
+func mapKeyFor(fd protoreflect.FieldDescriptor, key interface{}) (protoreflect.MapKey, bool) {
+	switch fd.MapKey().Kind() {
+	case protoreflect.BoolKind:
+		b, ok := key.(bool)
+		if !ok {
+			return protoreflect.MapKey{}, false
+		}
+		return protoreflect.ValueOfBool(b).MapKey(), true
+	case protoreflect.StringKind:
+		s, ok := key.(string)
+		if !ok {
+			return protoreflect.MapKey{}, false
+		}
+		return protoreflect.ValueOfString(s).MapKey(), true
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		i, ok := toInt64(key)
+		if !ok {
+			return protoreflect.MapKey{}, false
+		}
+		return protoreflect.ValueOfInt32(int32(i)).MapKey(), true
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		i, ok := toInt64(key)
+		if !ok {
+			return protoreflect.MapKey{}, false
+		}
+		return protoreflect.ValueOfInt64(i).MapKey(), true
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		u, ok := toUint64(key)
+		if !ok {
+			return protoreflect.MapKey{}, false
+		}
+		return protoreflect.ValueOfUint32(uint32(u)).MapKey(), true
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		u, ok := toUint64(key)
+		if !ok {
+			return protoreflect.MapKey{}, false
+		}
+		return protoreflect.ValueOfUint64(u).MapKey(), true
+	default:
+		return protoreflect.MapKey{}, false
+	}
+}
+
+// toInt64 widens any Go signed or unsigned integer kind to int64.
+func toInt64(v interface{}) (int64, bool) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return int64(rv.Uint()), true
+	default:
+		return 0, false
+	}
+}
+
+// toUint64 widens any Go signed or unsigned integer kind to uint64.
+func toUint64(v interface{}) (uint64, bool) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return rv.Uint(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i := rv.Int()
+		if i < 0 {
+			return 0, false
+		}
+		return uint64(i), true
+	default:
+		return 0, false
+	}
+}
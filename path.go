@@ -0,0 +1,179 @@
+package soldr
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+type segmentKind uint8
+
+const (
+	segChild segmentKind = iota
+	segIndex
+	segKey
+)
+
+type pathSegment struct {
+	kind segmentKind
+	name string
+	idx  int
+	key  interface{}
+}
+
+// resolvedStep is one entry of a Path's fully-resolved traversal chain,
+// carrying enough information (unlike the Child-only descriptor chain
+// returned by Fields) to walk a live message through repeated and map
+// fields: a segChild step carries the field descriptor to fetch, a
+// segIndex step carries the list index, and a segKey step carries the map
+// key.
+type resolvedStep struct {
+	kind segmentKind
+	fd   protoreflect.FieldDescriptor
+	idx  int
+	key  interface{}
+}
+
+// Path is a structured, protoreflect-validated alternative to a dotted field
+// path string. It is built fluently, e.g.
+//
+//	soldr.NewPath("user").Child("addresses").Index(0).Child("line1")
+//
+// and resolved against a protoreflect.MessageDescriptor the first time it is
+// used in an Assert* call, so a typo in a segment name fails loudly instead
+// of silently never matching.
+type Path struct {
+	root     string
+	segments []pathSegment
+
+	resolved bool
+	fields   []protoreflect.FieldDescriptor
+	steps    []resolvedStep
+}
+
+// NewPath starts a Path at the given top-level field name.
+func NewPath(root string) *Path {
+	return &Path{root: root}
+}
+
+// Child descends into a singular or map/repeated message field by name.
+func (p *Path) Child(name string) *Path {
+	return p.append(pathSegment{kind: segChild, name: name})
+}
+
+// Index descends into a repeated field at the given index.
+func (p *Path) Index(i int) *Path {
+	return p.append(pathSegment{kind: segIndex, idx: i})
+}
+
+// Key descends into a map field at the given key.
+func (p *Path) Key(k interface{}) *Path {
+	return p.append(pathSegment{kind: segKey, key: k})
+}
+
+func (p *Path) append(seg pathSegment) *Path {
+	segments := make([]pathSegment, len(p.segments), len(p.segments)+1)
+	copy(segments, p.segments)
+	segments = append(segments, seg)
+	return &Path{root: p.root, segments: segments}
+}
+
+// String renders the dotted/bracketed form of the path, e.g.
+// "user.addresses[2].line1".
+func (p *Path) String() string {
+	var b strings.Builder
+	b.WriteString(p.root)
+	for _, seg := range p.segments {
+		switch seg.kind {
+		case segChild:
+			b.WriteByte('.')
+			b.WriteString(seg.name)
+		case segIndex:
+			fmt.Fprintf(&b, "[%d]", seg.idx)
+		case segKey:
+			fmt.Fprintf(&b, "[%v]", seg.key)
+		}
+	}
+	return b.String()
+}
+
+// Fields returns the resolved field descriptor chain, one per Child/root
+// segment (Index/Key segments do not add a descriptor since they traverse
+// within the preceding field). Resolve must have been called first.
+func (p *Path) Fields() []protoreflect.FieldDescriptor {
+	return p.fields
+}
+
+// Steps returns the resolved traversal chain, including Index/Key segments,
+// suitable for walking a live message through repeated and map fields.
+// Resolve must have been called first.
+func (p *Path) Steps() []resolvedStep {
+	return p.steps
+}
+
+// Resolve validates every segment of the path against md, caches the
+// resolved field descriptor chain, and returns the dotted string form. It
+// panics on an invalid path so a typo fails loudly at build time rather than
+// silently never matching a field mask or presence check.
+func (p *Path) Resolve(md protoreflect.MessageDescriptor) string {
+	if p.resolved {
+		return p.String()
+	}
+
+	rootFd := md.Fields().ByName(protoreflect.Name(p.root))
+	if rootFd == nil {
+		panic(fmt.Sprintf("soldr: path %q: field %q does not exist on %s", p.String(), p.root, md.FullName()))
+	}
+
+	fields := []protoreflect.FieldDescriptor{rootFd}
+	steps := []resolvedStep{{kind: segChild, fd: rootFd}}
+	cur := rootFd
+	for _, seg := range p.segments {
+		switch seg.kind {
+		case segIndex:
+			if !cur.IsList() {
+				panic(fmt.Sprintf("soldr: path %q: field %q is not repeated", p.String(), cur.Name()))
+			}
+			steps = append(steps, resolvedStep{kind: segIndex, idx: seg.idx})
+		case segKey:
+			if !cur.IsMap() {
+				panic(fmt.Sprintf("soldr: path %q: field %q is not a map", p.String(), cur.Name()))
+			}
+			steps = append(steps, resolvedStep{kind: segKey, key: seg.key})
+		case segChild:
+			childMd := messageDescriptorOf(cur)
+			if childMd == nil {
+				panic(fmt.Sprintf("soldr: path %q: field %q is not a message", p.String(), cur.Name()))
+			}
+			fd := childMd.Fields().ByName(protoreflect.Name(seg.name))
+			if fd == nil {
+				panic(fmt.Sprintf("soldr: path %q: field %q does not exist on %s", p.String(), seg.name, childMd.FullName()))
+			}
+			fields = append(fields, fd)
+			steps = append(steps, resolvedStep{kind: segChild, fd: fd})
+			cur = fd
+		}
+	}
+
+	p.fields = fields
+	p.steps = steps
+	p.resolved = true
+	return p.String()
+}
+
+// messageDescriptorOf returns the message descriptor fd traverses into,
+// accounting for map fields (whose value, not the synthetic entry, is what
+// callers mean by "child").
+func messageDescriptorOf(fd protoreflect.FieldDescriptor) protoreflect.MessageDescriptor {
+	if fd.IsMap() {
+		if fd.MapValue().Kind() != protoreflect.MessageKind {
+			return nil
+		}
+		return fd.MapValue().Message()
+	}
+	if fd.Kind() != protoreflect.MessageKind && fd.Kind() != protoreflect.GroupKind {
+		return nil
+	}
+	return fd.Message()
+}
@@ -0,0 +1,54 @@
+package soldr
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// trigger decides whether a policy should be evaluated at all, e.g.
+// Field.MustBeSet or Field.MustBeSetIfInMask.
+type trigger func() bool
+
+// Policy pairs a Trait with the Field it evaluates and the trigger that
+// decides whether it runs.
+type Policy struct {
+	trait   Trait
+	trigger trigger
+	field   *Field
+}
+
+// NewPolicy builds a trait-backed Policy.
+func NewPolicy(trait Trait, trig trigger, field *Field) *Policy {
+	return &Policy{trait: trait, trigger: trig, field: field}
+}
+
+func (p *Policy) evaluate() *Fault {
+	if !p.trigger() {
+		return nil
+	}
+	return p.trait.Check(p.field)
+}
+
+// ActionPolicy pairs a caller-supplied Action with the Field it evaluates and
+// the trigger that decides whether it runs.
+type ActionPolicy[T proto.Message] struct {
+	trigger trigger
+	field   *Field
+	action  Action[T]
+}
+
+// NewActionPolicy builds an action-backed Policy.
+func NewActionPolicy[T proto.Message](trig trigger, field *Field, action Action[T]) *ActionPolicy[T] {
+	return &ActionPolicy[T]{trigger: trig, field: field, action: action}
+}
+
+func (p *ActionPolicy[T]) evaluate(ctx context.Context, message T) *Fault {
+	if !p.trigger() || p.action == nil {
+		return nil
+	}
+	if err := p.action(ctx, message); err != nil {
+		return FieldFault(p.field.Path, err)
+	}
+	return nil
+}
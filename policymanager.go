@@ -0,0 +1,44 @@
+package soldr
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// policyManager accumulates the trait and action policies declared against a
+// Subject and executes all of them on demand.
+type policyManager[T proto.Message] struct {
+	traitPolicies  []*Policy
+	actionPolicies []*ActionPolicy[T]
+}
+
+// NewPolicyManager builds an empty policyManager.
+func NewPolicyManager[T proto.Message]() *policyManager[T] {
+	return &policyManager[T]{}
+}
+
+func (pm *policyManager[T]) AddTraitPolicy(p *Policy) {
+	pm.traitPolicies = append(pm.traitPolicies, p)
+}
+
+func (pm *policyManager[T]) AddActionPolicy(p *ActionPolicy[T]) {
+	pm.actionPolicies = append(pm.actionPolicies, p)
+}
+
+// ExecuteAllPolicies runs every declared policy and returns the faults for
+// the ones that failed.
+func (pm *policyManager[T]) ExecuteAllPolicies(ctx context.Context, message T) []*Fault {
+	faults := make([]*Fault, 0)
+	for _, p := range pm.traitPolicies {
+		if fault := p.evaluate(); fault != nil {
+			faults = append(faults, fault)
+		}
+	}
+	for _, p := range pm.actionPolicies {
+		if fault := p.evaluate(ctx, message); fault != nil {
+			faults = append(faults, fault)
+		}
+	}
+	return faults
+}
@@ -2,9 +2,12 @@ package soldr
 
 import (
 	"context"
+	"fmt"
 	"reflect"
+	"strings"
 
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 const (
@@ -43,8 +46,17 @@ type Subject[T proto.Message] struct {
 	// the field store processor that accepts field labels and returns information about the field if it exists
 	fieldProcessor *fieldProcessor
 
+	// child subject evaluations registered via AssertSubject/AssertEach, run
+	// as part of Evaluate and merged into the same fault aggregate
+	children []func(ctx context.Context) []*Fault
+
 	// the message we are processing
 	message T
+
+	// old is the prior state of message, set by ForUpdate so AssertImmutable
+	// can resolve both sides of the comparison itself
+	old    T
+	hasOld bool
 }
 
 // For creates a new policy aggregate for the specified message that can be built upon using the
@@ -58,6 +70,16 @@ func ForSubject[T proto.Message](subject T, fieldMask ...string) *Subject[T] {
 	}
 }
 
+// ForUpdate creates a Subject for an update RPC, retaining old as the prior
+// state of the message so AssertImmutable can be called with just a path
+// (e.g. .AssertImmutable("user.id")) and resolve both sides itself.
+func ForUpdate[T proto.Message](new, old T, mask ...string) *Subject[T] {
+	s := ForSubject(new, mask...)
+	s.old = old
+	s.hasOld = true
+	return s
+}
+
 func getPathsFromMask(fieldMask ...string) map[string]struct{} {
 	if fieldMask == nil || len(fieldMask) == 0 {
 		return nil
@@ -73,65 +95,188 @@ func isZero(i interface{}) bool {
 	return i == nil || reflect.ValueOf(i).IsZero()
 }
 
-func (p *Subject[T]) isFieldSet(i interface{}, path string, isForAction bool) bool {
+// resolvePath accepts either a dotted field path string or a *Path and
+// returns the dotted string form plus, when a *Path was given, its resolved
+// field descriptor chain (for mask matching) and full traversal chain (for
+// O(1) presence checks, including through repeated/map fields). A *Path is
+// resolved against the subject's message descriptor here, so a typo in a
+// segment name panics at ForSubject/Assert* build time instead of silently
+// never matching.
+func (p *Subject[T]) resolvePath(path interface{}) (string, []protoreflect.FieldDescriptor, []resolvedStep) {
+	switch v := path.(type) {
+	case string:
+		return v, nil, nil
+	case *Path:
+		pathStr := v.Resolve(p.message.ProtoReflect().Descriptor())
+		return pathStr, v.Fields(), v.Steps()
+	default:
+		panic(fmt.Sprintf("soldr: unsupported path type %T, want string or *Path", path))
+	}
+}
+
+func (p *Subject[T]) isFieldSet(i interface{}, path string, steps []resolvedStep, isForAction bool) bool {
 	// no value to check on a custom action, so we have to source the trigger for the eval
 	if isForAction {
+		if steps != nil {
+			return p.fieldProcessor.isFieldSetByPath(steps, p.message)
+		}
 		return p.fieldProcessor.isFieldSet(path, p.message)
 	}
 
-	// else, check the val first to see if it's set and if not check the message
-	ifs := isZero(i)
-	if !ifs {
+	// else, check the val first to see if it's set and if not check the message.
+	// A zero value passed in tells us nothing about presence on its own (a
+	// proto3 scalar left unset reads back as its zero value too), so treat it
+	// as not-set rather than reporting it as set.
+	if !isZero(i) {
+		if steps != nil {
+			return p.fieldProcessor.isFieldSetByPath(steps, p.message)
+		}
 		return p.fieldProcessor.isFieldSet(path, p.message)
 	}
-	return ifs
+	return false
 }
 
-// HasNonZeroField pass in a list of fields that must not be equal to their
-// zero value
-//
-// example: sue := HasNonZeroFields("user.id", "user.first_name")
-func (p *Subject[T]) AssertNonZero(path string, value interface{}) *Subject[T] {
+// assertTrait is the shared implementation behind every trait-backed
+// Assert*/Assert*WhenInMask method: resolve the path, build the Field, and
+// register a Policy pairing trait with the right trigger.
+func (p *Subject[T]) assertTrait(path interface{}, value interface{}, whenInMask bool, trait Trait) *Subject[T] {
+	pathStr, fields, steps := p.resolvePath(path)
+
 	// check if field is in mask
-	inMask := p.isFieldInMask(path)
+	inMask := p.isFieldInMask(pathStr, fields)
 
 	// create a new field policy subject
-	field := NewField(path, value, inMask, p.isFieldSet(value, path, false))
+	field := NewField(pathStr, value, inMask, p.isFieldSet(value, pathStr, steps, false))
 
-	// create the trait policy for the field
-	traitPolicy := NewPolicy(NotZeroTrait(), field.MustBeSet, field)
+	trigger := field.MustBeSet
+	if whenInMask {
+		trigger = field.MustBeSetIfInMask
+	}
 
 	// add the policy to our manager
-	p.pm.AddTraitPolicy(traitPolicy)
+	p.pm.AddTraitPolicy(NewPolicy(trait, trigger, field))
 	return p
 }
 
+// AssertNonZero requires the field at path to be set to a non-zero value,
+// emitting a Required fault otherwise.
+//
+// example: ForSubject(req).AssertNonZero("user.id", req.GetUser().GetId())
+func (p *Subject[T]) AssertNonZero(path interface{}, value interface{}) *Subject[T] {
+	return p.assertTrait(path, value, false, NotZeroTrait())
+}
+
 // HasNonZeroFieldsWhen pass in a list of field conditions if you want to customize the conditions under which
 // a field non-zero evaluation is triggered
 //
 // example: sue := HasNonZeroFieldsWhen(IfInMask("user.first_name"), Always("user.first_name"))
-func (p *Subject[T]) AssertNonZeroWhenInMask(path string, value interface{}) *Subject[T] {
-	// check if field is in mask
-	inMask := p.isFieldInMask(path)
+func (p *Subject[T]) AssertNonZeroWhenInMask(path interface{}, value interface{}) *Subject[T] {
+	return p.assertTrait(path, value, true, NotZeroTrait())
+}
 
-	// create a new field policy subject
-	field := NewField(path, value, inMask, p.isFieldSet(value, path, false))
+// AssertOneOf requires value to equal one of allowed, emitting a
+// NotSupported fault otherwise.
+func (p *Subject[T]) AssertOneOf(path interface{}, value interface{}, allowed ...interface{}) *Subject[T] {
+	return p.assertTrait(path, value, false, OneOfTrait(allowed...))
+}
 
-	// create the trait policy for the field
-	traitPolicy := NewPolicy(NotZeroTrait(), field.MustBeSetIfInMask, field)
+// AssertOneOfWhenInMask is AssertOneOf, evaluated only when path is in the
+// field mask.
+func (p *Subject[T]) AssertOneOfWhenInMask(path interface{}, value interface{}, allowed ...interface{}) *Subject[T] {
+	return p.assertTrait(path, value, true, OneOfTrait(allowed...))
+}
 
-	// add the policy to our manager
-	p.pm.AddTraitPolicy(traitPolicy)
+// AssertRegex requires value to match pattern, emitting an Invalid fault
+// otherwise.
+func (p *Subject[T]) AssertRegex(path interface{}, value interface{}, pattern string) *Subject[T] {
+	return p.assertTrait(path, value, false, RegexTrait(pattern))
+}
+
+// AssertRegexWhenInMask is AssertRegex, evaluated only when path is in the
+// field mask.
+func (p *Subject[T]) AssertRegexWhenInMask(path interface{}, value interface{}, pattern string) *Subject[T] {
+	return p.assertTrait(path, value, true, RegexTrait(pattern))
+}
+
+// AssertLength requires value's length to fall within [min, max] (a max <= 0
+// means unbounded), emitting an Invalid or TooLong fault otherwise.
+func (p *Subject[T]) AssertLength(path interface{}, value interface{}, min, max int) *Subject[T] {
+	return p.assertTrait(path, value, false, LengthTrait(min, max))
+}
+
+// AssertLengthWhenInMask is AssertLength, evaluated only when path is in the
+// field mask.
+func (p *Subject[T]) AssertLengthWhenInMask(path interface{}, value interface{}, min, max int) *Subject[T] {
+	return p.assertTrait(path, value, true, LengthTrait(min, max))
+}
+
+// AssertRange requires value to fall within [min, max], emitting an Invalid
+// fault otherwise.
+func (p *Subject[T]) AssertRange(path interface{}, value interface{}, min, max interface{}) *Subject[T] {
+	return p.assertTrait(path, value, false, RangeTrait(min, max))
+}
+
+// AssertRangeWhenInMask is AssertRange, evaluated only when path is in the
+// field mask.
+func (p *Subject[T]) AssertRangeWhenInMask(path interface{}, value interface{}, min, max interface{}) *Subject[T] {
+	return p.assertTrait(path, value, true, RangeTrait(min, max))
+}
+
+// AssertUnique requires slice to contain no repeated elements, emitting a
+// Duplicate fault against the first repeat otherwise.
+func (p *Subject[T]) AssertUnique(path interface{}, slice interface{}) *Subject[T] {
+	return p.assertTrait(path, slice, false, UniqueTrait())
+}
+
+// AssertUniqueWhenInMask is AssertUnique, evaluated only when path is in the
+// field mask.
+func (p *Subject[T]) AssertUniqueWhenInMask(path interface{}, slice interface{}) *Subject[T] {
+	return p.assertTrait(path, slice, true, UniqueTrait())
+}
+
+// AssertImmutable requires that path, if present in the update mask, was not
+// changed from its prior value. Call it with an explicit (oldValue,
+// newValue) pair, or with none if the Subject was built with ForUpdate, in
+// which case both sides are resolved from the old/new messages via
+// protoreflect.
+func (p *Subject[T]) AssertImmutable(path interface{}, values ...interface{}) *Subject[T] {
+	pathStr, fields, steps := p.resolvePath(path)
+
+	var oldValue, newValue interface{}
+	switch len(values) {
+	case 0:
+		if !p.hasOld {
+			panic("soldr: AssertImmutable called with no values requires a Subject built with ForUpdate")
+		}
+		newValue, _ = p.fieldProcessor.value(pathStr, p.message)
+		oldValue, _ = p.fieldProcessor.value(pathStr, p.old)
+	case 2:
+		oldValue, newValue = values[0], values[1]
+	default:
+		panic("soldr: AssertImmutable takes either no values (with ForUpdate) or exactly (oldValue, newValue)")
+	}
+
+	inMask := p.isFieldInMask(pathStr, fields)
+	field := NewField(pathStr, immutablePair{old: oldValue, new: newValue}, inMask, p.isFieldSet(newValue, pathStr, steps, false))
+	p.pm.AddTraitPolicy(NewPolicy(ImmutableTrait(), field.MustBeSet, field))
 	return p
 }
 
+// AssertOutputOnly requires that the client did not set path: it may not be
+// present in the update mask, and value must be its zero value.
+func (p *Subject[T]) AssertOutputOnly(path interface{}, value interface{}) *Subject[T] {
+	return p.assertTrait(path, value, false, OutputOnlyTrait())
+}
+
 // HasCustomEvaluation sets the specified evaluation on the field and will be run if the conditions are met.
-func (p *Subject[T]) AssertCustom(path string, action Action[T]) *Subject[T] {
+func (p *Subject[T]) AssertCustom(path interface{}, action Action[T]) *Subject[T] {
+	pathStr, fields, steps := p.resolvePath(path)
+
 	// check if field is in mask
-	inMask := p.isFieldInMask(path)
+	inMask := p.isFieldInMask(pathStr, fields)
 
 	// create a policy subject
-	field := NewField(path, nil, inMask, p.isFieldSet(nil, path, true))
+	field := NewField(pathStr, nil, inMask, p.isFieldSet(nil, pathStr, steps, true))
 
 	// create a policy
 	actionPolicy := NewActionPolicy(field.MustBeSet, field, action)
@@ -142,12 +287,14 @@ func (p *Subject[T]) AssertCustom(path string, action Action[T]) *Subject[T] {
 }
 
 // HasCustomEvaluationWhen sets the specified evaluation on the field and will be run if the conditions are met
-func (p *Subject[T]) AssertCustomWhenInMask(path string, action Action[T]) *Subject[T] {
+func (p *Subject[T]) AssertCustomWhenInMask(path interface{}, action Action[T]) *Subject[T] {
+	pathStr, fields, steps := p.resolvePath(path)
+
 	// check if field is in mask
-	inMask := p.isFieldInMask(path)
+	inMask := p.isFieldInMask(pathStr, fields)
 
 	// create a policy subject
-	field := NewField(path, nil, inMask, p.isFieldSet(nil, path, true))
+	field := NewField(pathStr, nil, inMask, p.isFieldSet(nil, pathStr, steps, true))
 
 	// create a new action policy to evaluate
 	actionPolicy := NewActionPolicy(field.MustBeSetIfInMask, field, action)
@@ -174,12 +321,44 @@ func (s *Subject[T]) CustomFaultHandler(e FaultHandler) *Subject[T] {
 	return s
 }
 
-func (s *Subject[T]) isFieldInMask(path string) bool {
+// isFieldInMask reports whether path was present in the field mask passed to
+// ForSubject. Update masks are conventionally relative to the resource
+// field they update (e.g. an UpdateUserRequest's mask holds "first_name",
+// not "user.first_name"), while Assert* paths are fully qualified from the
+// request, so a match is tried against path's full dotted chain and every
+// suffix of it, not just the exact string. When fields is non-nil (path was
+// a resolved *Path), the same suffix match is also tried against the
+// JSON-name spelling, so a mask supplied in camelCase (as update masks from
+// JSON clients typically are) still matches a Path built from proto
+// snake_case segments.
+func (s *Subject[T]) isFieldInMask(path string, fields []protoreflect.FieldDescriptor) bool {
 	if s.paths == nil {
 		return false
 	}
-	_, inMask := s.paths[path]
-	return inMask
+	if matchesMaskSuffix(s.paths, strings.Split(path, ".")) {
+		return true
+	}
+	if fields == nil {
+		return false
+	}
+	segments := make([]string, len(fields))
+	for i, fd := range fields {
+		segments[i] = fd.JSONName()
+	}
+	return matchesMaskSuffix(s.paths, segments)
+}
+
+// matchesMaskSuffix reports whether mask contains the dotted path formed by
+// any suffix of segments, e.g. for segments ["user", "primary_address",
+// "line1"] it checks "user.primary_address.line1", "primary_address.line1",
+// and "line1" in turn.
+func matchesMaskSuffix(mask map[string]struct{}, segments []string) bool {
+	for i := range segments {
+		if _, inMask := mask[strings.Join(segments[i:], ".")]; inMask {
+			return true
+		}
+	}
+	return false
 }
 
 // E shorthand for Evaluate
@@ -242,10 +421,11 @@ func (s *Subject[T]) Evaluate(ctx context.Context) error {
 	}
 
 	// assert field traits based on their condition in the message
-	faults := []*Fault{}
-	allFaults := s.pm.ExecuteAllPolicies(ctx, s.message)
-	for subject, fault := range allFaults {
-		faults = append(faults, FieldFault(subject, fault))
+	faults := s.pm.ExecuteAllPolicies(ctx, s.message)
+
+	// recurse into any nested Subject compositions and merge their faults in
+	for _, child := range s.children {
+		faults = append(faults, child(ctx)...)
 	}
 
 	if len(faults) == 0 {
@@ -3,7 +3,6 @@ package soldr
 import (
 	"context"
 	"errors"
-	"fmt"
 	"testing"
 
 	proplv1 "buf.build/gen/go/signal426/propl/protocolbuffers/go/propl/v1"
@@ -11,16 +10,6 @@ import (
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
-type MyErrResultHandler struct{}
-
-func (my MyErrResultHandler) HandleErrs(errs []Fault) error {
-	var errString string
-	for _, err := range errs {
-		errString += fmt.Sprintf("%s: %s\n", err.Field, err.Err)
-	}
-	return errors.New(errString)
-}
-
 func TestFieldPolicies(t *testing.T) {
 	t.Run("it should validate non-zero", func(t *testing.T) {
 		// arrange
@@ -91,6 +80,25 @@ func TestFieldPolicies(t *testing.T) {
 		assert.Error(t, err)
 	})
 
+	t.Run("it should match a resource-relative mask entry against a fully-qualified assert path", func(t *testing.T) {
+		// arrange
+		req := &proplv1.UpdateUserRequest{
+			User: &proplv1.User{},
+			UpdateMask: &fieldmaskpb.FieldMask{
+				Paths: []string{"last_name"},
+			},
+		}
+
+		p := ForSubject(req, req.GetUpdateMask().Paths...).
+			AssertNonZeroWhenInMask("user.last_name", req.GetUser().GetLastName())
+
+		// act
+		err := p.E(context.Background())
+
+		// assert
+		assert.Error(t, err)
+	})
+
 	t.Run("it should validate custom optional action", func(t *testing.T) {
 		// arrange
 		req := &proplv1.UpdateUserRequest{
@@ -218,4 +226,158 @@ func TestFieldPolicies(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, req.GetUser().GetLastName(), "NA")
 	})
+
+	t.Run("it should validate using a structured path", func(t *testing.T) {
+		// arrange
+		req := &proplv1.UpdateUserRequest{
+			User: &proplv1.User{
+				FirstName: "bob",
+				PrimaryAddress: &proplv1.Address{
+					Line2: "b",
+				},
+			},
+			UpdateMask: &fieldmaskpb.FieldMask{
+				Paths: []string{"first_name", "last_name"},
+			},
+		}
+
+		p := ForSubject(req, req.GetUpdateMask().Paths...).
+			AssertNonZero(NewPath("user").Child("id"), req.GetUser().GetId()).
+			AssertNonZeroWhenInMask(NewPath("user").Child("primary_address").Child("line1"), req.GetUser().GetPrimaryAddress().GetLine1())
+
+		// act
+		err := p.E(context.Background())
+
+		// assert
+		assert.Error(t, err)
+	})
+
+	t.Run("it should validate the built-in trait library", func(t *testing.T) {
+		// arrange
+		req := &proplv1.CreateUserRequest{
+			User: &proplv1.User{
+				FirstName: "bob",
+				Id:        "not-an-email",
+			},
+		}
+
+		p := ForSubject(req).
+			AssertOneOf("user.first_name", req.GetUser().GetFirstName(), "alice", "carol").
+			AssertRegex("user.id", req.GetUser().GetId(), `^[^@]+@[^@]+$`).
+			AssertLength("user.first_name", req.GetUser().GetFirstName(), 10, 20).
+			AssertRange("user.first_name", len(req.GetUser().GetFirstName()), 10, 20).
+			AssertUnique("user.first_name", []string{"bob", "bob"})
+
+		// act
+		err := p.E(context.Background())
+
+		// assert
+		assert.Error(t, err)
+	})
+
+	t.Run("it should range-check numeric kinds against untyped int bounds", func(t *testing.T) {
+		// arrange
+		req := &proplv1.CreateUserRequest{
+			User: &proplv1.User{
+				FirstName: "bob",
+			},
+		}
+
+		p := ForSubject(req).
+			AssertRange("age", int32(30), 0, 120).
+			AssertRange("score", int64(30), 0, 120).
+			AssertRange("rating", float32(3.5), 0, 5)
+
+		// act
+		err := p.E(context.Background())
+
+		// assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("it should recurse into a nested message subject", func(t *testing.T) {
+		// arrange
+		req := &proplv1.UpdateUserRequest{
+			User: &proplv1.User{
+				FirstName: "bob",
+				PrimaryAddress: &proplv1.Address{
+					Line2: "b",
+				},
+			},
+		}
+
+		p := AssertSubject(ForSubject(req), "user.primary_address", func(child *Subject[*proplv1.Address]) *Subject[*proplv1.Address] {
+			return child.AssertNonZero("line1", child.message.GetLine1())
+		})
+
+		// act
+		err := p.E(context.Background())
+
+		// assert
+		assert.Error(t, err)
+		assert.ErrorContains(t, err, "user.primary_address.line1")
+	})
+
+	t.Run("it should reject an immutable field changed in an update", func(t *testing.T) {
+		// arrange
+		old := &proplv1.UpdateUserRequest{
+			User: &proplv1.User{Id: "abc123", FirstName: "bob"},
+		}
+		new := &proplv1.UpdateUserRequest{
+			User: &proplv1.User{Id: "xyz789", FirstName: "bob"},
+		}
+
+		p := ForUpdate(new, old, "user.id").
+			AssertImmutable("user.id")
+
+		// act
+		err := p.E(context.Background())
+
+		// assert
+		assert.Error(t, err)
+	})
+
+	t.Run("it should reject an output-only field set by the client", func(t *testing.T) {
+		// arrange
+		req := &proplv1.UpdateUserRequest{
+			User: &proplv1.User{Id: "abc123"},
+		}
+
+		p := ForSubject(req).
+			AssertOutputOnly("user.id", req.GetUser().GetId())
+
+		// act
+		err := p.E(context.Background())
+
+		// assert
+		assert.Error(t, err)
+	})
+
+	t.Run("it should accept an output-only field the client left unset", func(t *testing.T) {
+		// arrange
+		req := &proplv1.UpdateUserRequest{
+			User: &proplv1.User{FirstName: "bob"},
+		}
+
+		p := ForSubject(req).
+			AssertOutputOnly("user.id", req.GetUser().GetId())
+
+		// act
+		err := p.E(context.Background())
+
+		// assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("it should panic on a structured path with a typo'd segment", func(t *testing.T) {
+		// arrange
+		req := &proplv1.CreateUserRequest{
+			User: &proplv1.User{},
+		}
+
+		// act + assert
+		assert.Panics(t, func() {
+			ForSubject(req).AssertNonZero(NewPath("user").Child("primary_addres"), nil)
+		})
+	})
 }
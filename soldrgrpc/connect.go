@@ -0,0 +1,61 @@
+package soldrgrpc
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/signal426/soldr"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+)
+
+// NewConnectInterceptor is the Connect equivalent of
+// UnaryServerInterceptor: it validates the request against its registered
+// Builder before calling the next handler, short-circuiting with the same
+// InvalidArgument status and google.rpc.BadRequest detail on failure.
+func NewConnectInterceptor(opts ...Option) connect.UnaryInterceptorFunc {
+	cfg := newConfig(opts...)
+	interceptor := func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			msg, ok := req.Any().(proto.Message)
+			if !ok {
+				return next(ctx, req)
+			}
+			v, ok := lookup(req.Spec().Procedure, msg)
+			if !ok {
+				if cfg.requireRegistration {
+					return nil, connect.NewError(connect.CodeInternal, errNoValidator(req.Spec().Procedure))
+				}
+				return next(ctx, req)
+			}
+			if faults, err := v(ctx, msg); err != nil {
+				return nil, toConnectError(faults)
+			}
+			return next(ctx, req)
+		}
+	}
+	return connect.UnaryInterceptorFunc(interceptor)
+}
+
+// toConnectError is the Connect equivalent of toStatusError: Connect errors
+// don't carry gRPC status details, so the google.rpc.BadRequest is attached
+// as a Connect error detail instead of embedded in a wrapped status.
+func toConnectError(faults []*soldr.Fault) error {
+	violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(faults))
+	for _, f := range faults {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       f.Field,
+			Description: f.Error(),
+		})
+	}
+
+	cerr := connect.NewError(connect.CodeInvalidArgument, errValidationFailed)
+	detail, err := connect.NewErrorDetail(&errdetails.BadRequest{FieldViolations: violations})
+	if err != nil {
+		// detail marshaling should never fail for a well-formed BadRequest, but
+		// fall back to the plain error rather than losing the failure.
+		return cerr
+	}
+	cerr.AddDetail(detail)
+	return cerr
+}
@@ -0,0 +1,162 @@
+// Package soldrgrpc wires soldr.Subject validation into the gRPC and Connect
+// request lifecycle, so a request that fails validation never reaches a
+// handler and the caller gets back a standard InvalidArgument status instead
+// of a handwritten error.
+package soldrgrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/signal426/soldr"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// errValidationFailed is the error surfaced to callers when a request fails
+// validation; the actionable detail lives in the per-protocol BadRequest
+// attached alongside it (a gRPC status detail or a Connect error detail),
+// not in this message.
+var errValidationFailed = errors.New("request failed validation")
+
+// Builder builds the validation Subject for a request message. Register it
+// once per message type (or method) with Register/RegisterMethod.
+type Builder[T proto.Message] func(req T) *soldr.Subject[T]
+
+// validator is the type-erased form of a Builder, looked up by message type
+// or full method name at request time. It returns the raw faults alongside
+// a non-nil error so each protocol's interceptor can render them into its
+// own error shape (a gRPC status detail vs. a Connect error detail).
+type validator func(ctx context.Context, req proto.Message) ([]*soldr.Fault, error)
+
+var (
+	byType   = map[reflect.Type]validator{}
+	byMethod = map[string]validator{}
+)
+
+// Register associates a Builder with its request message type T. Any RPC
+// whose request is a T is validated with it, unless a more specific
+// RegisterMethod entry exists for that method.
+func Register[T proto.Message](builder Builder[T]) {
+	var zero T
+	byType[reflect.TypeOf(zero)] = adapt(builder)
+}
+
+// RegisterMethod associates a Builder with a specific full method name, e.g.
+// "/propl.v1.UserService/CreateUser". It takes precedence over a
+// type-registered Builder for the same request message.
+func RegisterMethod[T proto.Message](fullMethod string, builder Builder[T]) {
+	byMethod[fullMethod] = adapt(builder)
+}
+
+func adapt[T proto.Message](builder Builder[T]) validator {
+	return func(ctx context.Context, req proto.Message) ([]*soldr.Fault, error) {
+		typed, ok := req.(T)
+		if !ok {
+			return nil, nil
+		}
+		capture := &faultCapture{}
+		_ = builder(typed).CustomFaultHandler(capture).E(ctx)
+		if len(capture.faults) == 0 {
+			return nil, nil
+		}
+		return capture.faults, errValidationFailed
+	}
+}
+
+// faultCapture is a soldr.FaultHandler that keeps the raw faults around so
+// they can be translated into a google.rpc.BadRequest detail instead of a
+// flattened error string.
+type faultCapture struct {
+	faults []*soldr.Fault
+}
+
+func (f *faultCapture) ToError(faults []*soldr.Fault) error {
+	f.faults = faults
+	return nil
+}
+
+func toStatusError(faults []*soldr.Fault) error {
+	violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(faults))
+	for _, f := range faults {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       f.Field,
+			Description: f.Error(),
+		})
+	}
+
+	st := status.New(codes.InvalidArgument, errValidationFailed.Error())
+	withDetails, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if err != nil {
+		// detail marshaling should never fail for a well-formed BadRequest, but
+		// fall back to the plain status rather than losing the failure.
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+func errNoValidator(fullMethod string) error {
+	return fmt.Errorf("soldrgrpc: no validator registered for %s", fullMethod)
+}
+
+// lookup resolves the validator registered for fullMethod/req, preferring a
+// method-specific registration over a type-based one.
+func lookup(fullMethod string, req proto.Message) (validator, bool) {
+	if v, ok := byMethod[fullMethod]; ok {
+		return v, true
+	}
+	v, ok := byType[reflect.TypeOf(req)]
+	return v, ok
+}
+
+// UnaryServerInterceptor validates the request against its registered
+// Builder before invoking the handler, returning an InvalidArgument status
+// with a google.rpc.BadRequest detail on failure.
+func UnaryServerInterceptor(opts ...Option) grpc.UnaryServerInterceptor {
+	cfg := newConfig(opts...)
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		msg, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+		v, ok := lookup(info.FullMethod, msg)
+		if !ok {
+			if cfg.requireRegistration {
+				return nil, status.Error(codes.Internal, errNoValidator(info.FullMethod).Error())
+			}
+			return handler(ctx, req)
+		}
+		if faults, err := v(ctx, msg); err != nil {
+			return nil, toStatusError(faults)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// Option configures UnaryServerInterceptor.
+type Option func(*config)
+
+type config struct {
+	requireRegistration bool
+}
+
+func newConfig(opts ...Option) *config {
+	cfg := &config{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return cfg
+}
+
+// WithRequireRegistration makes the interceptor reject any RPC that has no
+// registered Builder, instead of silently letting it through unvalidated.
+func WithRequireRegistration() Option {
+	return func(c *config) {
+		c.requireRegistration = true
+	}
+}
@@ -0,0 +1,57 @@
+package soldrgrpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/signal426/soldr"
+	proplv1 "buf.build/gen/go/signal426/propl/protocolbuffers/go/propl/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	Register(func(req *proplv1.CreateUserRequest) *soldr.Subject[*proplv1.CreateUserRequest] {
+		return soldr.ForSubject(req).
+			AssertNonZero("user.first_name", req.GetUser().GetFirstName())
+	})
+
+	handlerCalled := false
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		handlerCalled = true
+		return nil, nil
+	}
+
+	t.Run("it should short circuit with InvalidArgument on a failed validation", func(t *testing.T) {
+		handlerCalled = false
+		interceptor := UnaryServerInterceptor()
+
+		_, err := interceptor(
+			context.Background(),
+			&proplv1.CreateUserRequest{User: &proplv1.User{}},
+			&grpc.UnaryServerInfo{FullMethod: "/propl.v1.UserService/CreateUser"},
+			handler,
+		)
+
+		assert.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.False(t, handlerCalled)
+	})
+
+	t.Run("it should call the handler when validation passes", func(t *testing.T) {
+		handlerCalled = false
+		interceptor := UnaryServerInterceptor()
+
+		_, err := interceptor(
+			context.Background(),
+			&proplv1.CreateUserRequest{User: &proplv1.User{FirstName: "bob"}},
+			&grpc.UnaryServerInfo{FullMethod: "/propl.v1.UserService/CreateUser"},
+			handler,
+		)
+
+		assert.NoError(t, err)
+		assert.True(t, handlerCalled)
+	})
+}
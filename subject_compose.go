@@ -0,0 +1,163 @@
+package soldr
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// captureFaultHandler is a FaultHandler that stashes the raw faults instead
+// of formatting them, so a composing Subject can rebase and re-aggregate
+// them under its own path.
+type captureFaultHandler struct {
+	faults []*Fault
+}
+
+func (c *captureFaultHandler) ToError(faults []*Fault) error {
+	c.faults = faults
+	return nil
+}
+
+// prefixFaults rebases each fault's Field under prefix, e.g. rebasing
+// "line1" under "user.primary_address" yields "user.primary_address.line1".
+func prefixFaults(prefix string, faults []*Fault) []*Fault {
+	out := make([]*Fault, len(faults))
+	for i, f := range faults {
+		rebased := *f
+		if rebased.Field == "" {
+			rebased.Field = prefix
+		} else {
+			rebased.Field = prefix + "." + rebased.Field
+		}
+		out[i] = &rebased
+	}
+	return out
+}
+
+// rebaseMask strips prefix+"." from every entry of mask that has it,
+// producing the mask a child Subject scoped to prefix should see. Entries
+// that aren't under prefix are dropped since they can't apply to the child.
+func rebaseMask(mask map[string]struct{}, prefix string) []string {
+	if mask == nil {
+		return nil
+	}
+	rebased := make([]string, 0, len(mask))
+	for path := range mask {
+		if rest, ok := strings.CutPrefix(path, prefix+"."); ok {
+			rebased = append(rebased, rest)
+		}
+	}
+	return rebased
+}
+
+// nestedMessage resolves path against parent via protoreflect and returns
+// the message it points at, asserted to concrete type M.
+func nestedMessage[M proto.Message](parent proto.Message, path string) (M, bool) {
+	var zero M
+	if parent == nil {
+		return zero, false
+	}
+	m := parent.ProtoReflect()
+	for _, seg := range strings.Split(path, ".") {
+		if !m.IsValid() {
+			return zero, false
+		}
+		fd := m.Descriptor().Fields().ByName(protoreflect.Name(seg))
+		if fd == nil || fd.Message() == nil {
+			return zero, false
+		}
+		m = m.Get(fd).Message()
+	}
+	typed, ok := m.Interface().(M)
+	return typed, ok
+}
+
+// nestedMessageList is nestedMessage for a repeated message field, returning
+// one element per entry.
+func nestedMessageList[M proto.Message](parent proto.Message, path string) ([]M, bool) {
+	if parent == nil {
+		return nil, false
+	}
+	segments := strings.Split(path, ".")
+	m := parent.ProtoReflect()
+	for i, seg := range segments {
+		if !m.IsValid() {
+			return nil, false
+		}
+		fd := m.Descriptor().Fields().ByName(protoreflect.Name(seg))
+		if fd == nil {
+			return nil, false
+		}
+		if i == len(segments)-1 {
+			if !fd.IsList() || fd.Message() == nil {
+				return nil, false
+			}
+			list := m.Get(fd).List()
+			out := make([]M, list.Len())
+			for j := 0; j < list.Len(); j++ {
+				typed, ok := list.Get(j).Message().Interface().(M)
+				if !ok {
+					return nil, false
+				}
+				out[j] = typed
+			}
+			return out, true
+		}
+		if fd.Message() == nil {
+			return nil, false
+		}
+		m = m.Get(fd).Message()
+	}
+	return nil, false
+}
+
+// AssertSubject validates a singular nested message field by building a
+// child Subject[M] for it and merging its faults into parent's, each
+// rebased under path (e.g. a child fault at "line1" becomes
+// "user.primary_address.line1"). The child inherits parent's field mask,
+// rebased the same way.
+func AssertSubject[T proto.Message, M proto.Message](parent *Subject[T], path interface{}, sub func(child *Subject[M]) *Subject[M]) *Subject[T] {
+	pathStr, _, _ := parent.resolvePath(path)
+
+	parent.children = append(parent.children, func(ctx context.Context) []*Fault {
+		msg, ok := nestedMessage[M](parent.message, pathStr)
+		if !ok {
+			return nil
+		}
+		child := sub(ForSubject(msg, rebaseMask(parent.paths, pathStr)...))
+		capture := &captureFaultHandler{}
+		child.CustomFaultHandler(capture)
+		_ = child.E(ctx)
+		return prefixFaults(pathStr, capture.faults)
+	})
+	return parent
+}
+
+// AssertEach validates a repeated nested message field by building a child
+// Subject[M] for every element and merging its faults into parent's, each
+// rebased under path[i] (e.g. "line1" at index 2 becomes
+// "user.addresses[2].line1").
+func AssertEach[T proto.Message, M proto.Message](parent *Subject[T], path interface{}, sub func(i int, child *Subject[M]) *Subject[M]) *Subject[T] {
+	pathStr, _, _ := parent.resolvePath(path)
+
+	parent.children = append(parent.children, func(ctx context.Context) []*Fault {
+		msgs, ok := nestedMessageList[M](parent.message, pathStr)
+		if !ok {
+			return nil
+		}
+		var faults []*Fault
+		for i, msg := range msgs {
+			elemPrefix := fmt.Sprintf("%s[%d]", pathStr, i)
+			child := sub(i, ForSubject(msg, rebaseMask(parent.paths, pathStr)...))
+			capture := &captureFaultHandler{}
+			child.CustomFaultHandler(capture)
+			_ = child.E(ctx)
+			faults = append(faults, prefixFaults(elemPrefix, capture.faults)...)
+		}
+		return faults
+	})
+	return parent
+}
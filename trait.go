@@ -0,0 +1,24 @@
+package soldr
+
+// Trait evaluates a single Field and returns the Fault describing why it
+// failed, or nil if it passed.
+type Trait interface {
+	Check(field *Field) *Fault
+}
+
+// traitFunc adapts a plain function to the Trait interface.
+type traitFunc func(field *Field) *Fault
+
+func (t traitFunc) Check(field *Field) *Fault {
+	return t(field)
+}
+
+// NotZeroTrait reports a Required fault when the field is not set.
+func NotZeroTrait() Trait {
+	return traitFunc(func(field *Field) *Fault {
+		if field.IsSet {
+			return nil
+		}
+		return RequiredFault(field.Path)
+	})
+}
@@ -0,0 +1,189 @@
+package soldr
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+)
+
+// OneOfTrait reports a NotSupported fault when the field's value is not one
+// of allowed. Values are compared with reflect.DeepEqual, so allowed must
+// hold the exact same concrete type as the field's value (e.g. int32(5), not
+// int(5), for an int32 proto field) or the comparison never matches.
+func OneOfTrait(allowed ...interface{}) Trait {
+	return traitFunc(func(field *Field) *Fault {
+		for _, a := range allowed {
+			if reflect.DeepEqual(field.Value, a) {
+				return nil
+			}
+		}
+		return NotSupportedFault(field.Path, field.Value, allowed)
+	})
+}
+
+// RegexTrait reports an Invalid fault when the field's string value does not
+// match pattern.
+func RegexTrait(pattern string) Trait {
+	re := regexp.MustCompile(pattern)
+	return traitFunc(func(field *Field) *Fault {
+		s, ok := field.Value.(string)
+		if !ok {
+			return TypeInvalidFault(field.Path, field.Value)
+		}
+		if re.MatchString(s) {
+			return nil
+		}
+		return InvalidFault(field.Path, field.Value, fmt.Sprintf("does not match pattern %q", pattern))
+	})
+}
+
+// LengthTrait reports an Invalid fault when the field's string/slice/map/
+// array value has fewer than min elements, or a TooLong fault when it has
+// more than max. A max <= 0 means unbounded.
+func LengthTrait(min, max int) Trait {
+	return traitFunc(func(field *Field) *Fault {
+		n, ok := length(field.Value)
+		if !ok {
+			return TypeInvalidFault(field.Path, field.Value)
+		}
+		if n < min {
+			return InvalidFault(field.Path, field.Value, fmt.Sprintf("must be at least %d", min))
+		}
+		if max > 0 && n > max {
+			return TooLongFault(field.Path, field.Value, max)
+		}
+		return nil
+	})
+}
+
+func length(v interface{}) (int, bool) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map:
+		return rv.Len(), true
+	default:
+		return 0, false
+	}
+}
+
+// RangeTrait reports an Invalid fault when the field's ordered (numeric or
+// string) value falls outside [min, max].
+func RangeTrait(min, max interface{}) Trait {
+	return traitFunc(func(field *Field) *Fault {
+		belowMin, ok := less(field.Value, min)
+		if !ok {
+			return TypeInvalidFault(field.Path, field.Value)
+		}
+		if belowMin {
+			return InvalidFault(field.Path, field.Value, fmt.Sprintf("must be >= %v", min))
+		}
+		aboveMax, ok := less(max, field.Value)
+		if !ok {
+			return TypeInvalidFault(field.Path, field.Value)
+		}
+		if aboveMax {
+			return InvalidFault(field.Path, field.Value, fmt.Sprintf("must be <= %v", max))
+		}
+		return nil
+	})
+}
+
+// less reports whether a < b for two ordered (numeric or string) values. The
+// numeric kinds are normalized through numericValue first, so e.g. an
+// int32 field value compares fine against untyped-int bounds; string only
+// compares against string. ok is false when a and b aren't comparable this
+// way.
+func less(a, b interface{}) (bool, bool) {
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+
+	if isNumericKind(va.Kind()) && isNumericKind(vb.Kind()) {
+		return numericValue(va) < numericValue(vb), true
+	}
+	if va.Kind() == reflect.String && vb.Kind() == reflect.String {
+		return va.String() < vb.String(), true
+	}
+	return false, false
+}
+
+// isNumericKind reports whether k is one of reflect's int/uint/float kinds.
+func isNumericKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// numericValue normalizes any int/uint/float reflect.Value to a float64 so
+// values of differing numeric kinds (e.g. int32 vs untyped int) can be
+// compared directly.
+func numericValue(v reflect.Value) float64 {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	default:
+		return 0
+	}
+}
+
+// immutablePair carries both sides of an immutability check through a
+// Field, since Field otherwise only holds one Value.
+type immutablePair struct {
+	old, new interface{}
+}
+
+// ImmutableTrait reports a Forbidden fault when the field is in the update
+// mask and its value differs from the prior value.
+func ImmutableTrait() Trait {
+	return traitFunc(func(field *Field) *Fault {
+		pair, ok := field.Value.(immutablePair)
+		if !ok || !field.InMask || reflect.DeepEqual(pair.old, pair.new) {
+			return nil
+		}
+		return ForbiddenFault(field.Path, "field is immutable and may not be changed")
+	})
+}
+
+// OutputOnlyTrait reports a Forbidden fault when the client set the field to
+// a non-zero value or included it in the update mask.
+func OutputOnlyTrait() Trait {
+	return traitFunc(func(field *Field) *Fault {
+		if field.IsSet || field.InMask {
+			return ForbiddenFault(field.Path, "field is output-only and may not be set by the client")
+		}
+		return nil
+	})
+}
+
+// UniqueTrait reports a Duplicate fault against the first repeated element
+// of the field's slice/array value.
+func UniqueTrait() Trait {
+	return traitFunc(func(field *Field) *Fault {
+		rv := reflect.ValueOf(field.Value)
+		if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+			return TypeInvalidFault(field.Path, field.Value)
+		}
+		seen := make(map[string]int, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			elem := rv.Index(i).Interface()
+			key := fmt.Sprint(elem)
+			if firstIdx, ok := seen[key]; ok {
+				return &Fault{
+					Field:    field.Path,
+					Type:     FaultTypeDuplicate,
+					BadValue: elem,
+					Detail:   fmt.Sprintf("duplicate of the value at index %d, found again at index %d", firstIdx, i),
+				}
+			}
+			seen[key] = i
+		}
+		return nil
+	})
+}